@@ -0,0 +1,83 @@
+package errorkit
+
+// checkPanic is the private sentinel panicked by the Check family so Handle
+// and Catch can recover it while letting foreign panics propagate unchanged.
+type checkPanic struct {
+	err error
+}
+
+// Check panics with a private sentinel when err is non-nil. It is meant to
+// be used together with a deferred Handle call in the same function, turning
+// a chain of error-returning calls into linear code:
+//
+//	func DoSomething() (err error) {
+//		defer Handle(&err)
+//		Check(step1())
+//		Check(step2())
+//		return nil
+//	}
+func Check(err error) {
+	if err != nil {
+		panic(checkPanic{err: err})
+	}
+}
+
+// Check1 is the generic variant of Check for a call returning a result and
+// an error. It returns the result when err is nil.
+func Check1[T any](v T, err error) T {
+	Check(err)
+	return v
+}
+
+// Check2 is the generic variant of Check for a call returning two results
+// and an error.
+func Check2[T1, T2 any](v1 T1, v2 T2, err error) (T1, T2) {
+	Check(err)
+	return v1, v2
+}
+
+// Check3 is the generic variant of Check for a call returning three results
+// and an error.
+func Check3[T1, T2, T3 any](v1 T1, v2 T2, v3 T3, err error) (T1, T2, T3) {
+	Check(err)
+	return v1, v2, v3
+}
+
+// Handle recovers a panic raised by Check and its generic variants, assigns
+// the carried error to *errptr, and invokes handlers in order. Panics not
+// raised by Check propagate unchanged. Handle must be deferred directly in
+// the function whose named error result errptr points to.
+func Handle(errptr *error, handlers ...func(error)) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	cp, ok := r.(checkPanic)
+	if !ok {
+		panic(r)
+	}
+
+	*errptr = cp.err
+	for _, h := range handlers {
+		h(cp.err)
+	}
+}
+
+// Catch recovers a panic raised by Check and its generic variants and passes
+// the carried error to catch. Panics not raised by Check propagate
+// unchanged. Catch is meant to be deferred at the top of a goroutine, where
+// there is no *error result to assign via Handle.
+func Catch(catch func(error)) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	cp, ok := r.(checkPanic)
+	if !ok {
+		panic(r)
+	}
+
+	catch(cp.err)
+}
@@ -0,0 +1,93 @@
+package errorkit
+
+import (
+	"errors"
+	"testing"
+)
+
+func divide(a, b int) (int, error) {
+	if b == 0 {
+		return 0, errors.New("division by zero")
+	}
+	return a / b, nil
+}
+
+func TestCheck_Handle(t *testing.T) {
+	run := func(b int) (result int, err error) {
+		defer Handle(&err)
+		result = Check1(divide(10, b))
+		return result, nil
+	}
+
+	result, err := run(2)
+	if err != nil || result != 5 {
+		t.Errorf("run(2) = %v, %v, want 5, nil", result, err)
+	}
+
+	result, err = run(0)
+	if err == nil || err.Error() != "division by zero" {
+		t.Errorf("run(0) error = %v, want %q", err, "division by zero")
+	}
+	if result != 0 {
+		t.Errorf("run(0) result = %v, want 0", result)
+	}
+}
+
+func TestHandle_InvokesHandlersInOrder(t *testing.T) {
+	var order []int
+
+	func() {
+		var err error
+		defer Handle(&err,
+			func(error) { order = append(order, 1) },
+			func(error) { order = append(order, 2) },
+		)
+		Check(errors.New("boom"))
+	}()
+
+	if len(order) != 2 || order[0] != 1 || order[1] != 2 {
+		t.Errorf("handler order = %v, want [1 2]", order)
+	}
+}
+
+func TestHandle_LetsForeignPanicsPropagate(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("foreign panic was swallowed by Handle")
+		}
+	}()
+
+	func() {
+		var err error
+		defer Handle(&err)
+		panic("not a checkPanic")
+	}()
+}
+
+func TestCatch(t *testing.T) {
+	var caught error
+
+	func() {
+		defer Catch(func(err error) {
+			caught = err
+		})
+		Check(errors.New("boom"))
+	}()
+
+	if caught == nil || caught.Error() != "boom" {
+		t.Errorf("Catch() caught = %v, want %q", caught, "boom")
+	}
+}
+
+func TestCatch_LetsForeignPanicsPropagate(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("foreign panic was swallowed by Catch")
+		}
+	}()
+
+	func() {
+		defer Catch(func(error) {})
+		panic("not a checkPanic")
+	}()
+}
@@ -2,7 +2,6 @@ package errorkit
 
 import (
 	"fmt"
-	"runtime/debug"
 )
 
 // Validate creates an error when a condition is not met.
@@ -18,7 +17,7 @@ func Validate(condition bool, format string, args ...any) error {
 func Try(fn func() error) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			err = fmt.Errorf("panic occurred: %v\nStack trace:\n%s", r, debug.Stack())
+			err = newPanicError(r)
 		}
 	}()
 
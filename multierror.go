@@ -0,0 +1,105 @@
+package errorkit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// MultiError aggregates the errors from multiple independent operations.
+// Its Unwrap method follows the Go 1.20 multi-error convention, so
+// errors.Is and errors.As traverse into every aggregated error, including
+// recovered panics.
+type MultiError struct {
+	Errors []error
+}
+
+func (e *MultiError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d errors occurred:", len(e.Errors))
+	for _, err := range e.Errors {
+		b.WriteString("\n\t* ")
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the aggregated errors so errors.Is and errors.As traverse
+// into each of them.
+func (e *MultiError) Unwrap() []error {
+	return e.Errors
+}
+
+// newMultiError collects the non-nil errors in errs into a *MultiError. It
+// returns nil if none are non-nil.
+func newMultiError(errs []error) error {
+	var nonNil []error
+	for _, err := range errs {
+		if err != nil {
+			nonNil = append(nonNil, err)
+		}
+	}
+	if len(nonNil) == 0 {
+		return nil
+	}
+	return &MultiError{Errors: nonNil}
+}
+
+// TryAll runs each fn under Try in sequence, recovering panics into
+// PanicErrors, and aggregates any failures into a *MultiError.
+func TryAll(fns ...func() error) error {
+	errs := make([]error, len(fns))
+	for i, fn := range fns {
+		errs[i] = Try(fn)
+	}
+	return newMultiError(errs)
+}
+
+// TryAllParallel runs each fn under Try concurrently, recovering panics into
+// PanicErrors, and aggregates any failures into a *MultiError. If ctx is
+// done before every fn finishes, it returns early with ctx.Err() aggregated
+// alongside whichever failures had already completed.
+func TryAllParallel(ctx context.Context, fns ...func() error) error {
+	type indexedErr struct {
+		index int
+		err   error
+	}
+
+	results := make(chan indexedErr, len(fns))
+	for i, fn := range fns {
+		go func(i int, fn func() error) {
+			results <- indexedErr{index: i, err: Try(fn)}
+		}(i, fn)
+	}
+
+	errs := make([]error, len(fns))
+	for range fns {
+		select {
+		case r := <-results:
+			errs[r.index] = r.err
+		case <-ctx.Done():
+			errs = append(errs, ctx.Err())
+			return newMultiError(errs)
+		}
+	}
+	return newMultiError(errs)
+}
+
+// Try1All runs each fn under Try1 in sequence, recovering panics into
+// PanicErrors, and returns the collected results alongside any failures
+// aggregated into a *MultiError. The result slice has the same length and
+// order as fns; an entry for a call that returned an error holds whatever
+// that call returned, and an entry for a call that panicked holds the zero
+// value.
+func Try1All[T any](fns ...func() (T, error)) ([]T, error) {
+	results := make([]T, len(fns))
+	errs := make([]error, len(fns))
+	for i, fn := range fns {
+		results[i], errs[i] = Try1(fn)
+	}
+	return results, newMultiError(errs)
+}
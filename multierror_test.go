@@ -0,0 +1,88 @@
+package errorkit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTryAll(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+
+	err := TryAll(
+		func() error { return nil },
+		func() error { return err1 },
+		func() error { return err2 },
+		func() error { panic("boom") },
+	)
+
+	var me *MultiError
+	if !errors.As(err, &me) {
+		t.Fatalf("errors.As() failed to extract *MultiError from %v", err)
+	}
+	if len(me.Errors) != 3 {
+		t.Fatalf("MultiError.Errors has %d entries, want 3", len(me.Errors))
+	}
+	if !errors.Is(err, err1) || !errors.Is(err, err2) {
+		t.Errorf("errors.Is() failed to find an aggregated child error")
+	}
+	if !errors.Is(err, ErrPanic) {
+		t.Errorf("errors.Is(err, ErrPanic) = false, want true")
+	}
+}
+
+func TestTryAll_AllSucceed(t *testing.T) {
+	err := TryAll(
+		func() error { return nil },
+		func() error { return nil },
+	)
+	if err != nil {
+		t.Errorf("TryAll() error = %v, want nil", err)
+	}
+}
+
+func TestTryAllParallel(t *testing.T) {
+	err1 := errors.New("err1")
+
+	err := TryAllParallel(context.Background(),
+		func() error { return nil },
+		func() error { return err1 },
+	)
+
+	if !errors.Is(err, err1) {
+		t.Errorf("errors.Is(err, err1) = false, want true")
+	}
+}
+
+func TestTryAllParallel_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := TryAllParallel(ctx,
+		func() error {
+			time.Sleep(50 * time.Millisecond)
+			return nil
+		},
+	)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("errors.Is(err, context.Canceled) = false, want true")
+	}
+}
+
+func TestTry1All(t *testing.T) {
+	results, err := Try1All(
+		func() (int, error) { return 1, nil },
+		func() (int, error) { return 0, errors.New("boom") },
+		func() (int, error) { return 3, nil },
+	)
+
+	if len(results) != 3 || results[0] != 1 || results[2] != 3 {
+		t.Errorf("Try1All() results = %v, want [1 0 3]", results)
+	}
+	if err == nil {
+		t.Errorf("Try1All() error = nil, want non-nil")
+	}
+}
@@ -0,0 +1,192 @@
+package errorkit
+
+import (
+	"reflect"
+	"runtime/debug"
+)
+
+// TryOption configures the panic-recovery behavior of the TryWith family.
+type TryOption func(*tryConfig)
+
+type tryConfig struct {
+	panicFilter  func(recovered any) bool
+	repanicOn    []reflect.Type
+	panicWrapper func(recovered any, stack []byte) error
+}
+
+// WithPanicFilter sets a predicate that decides whether a recovered panic is
+// converted into an error. Returning false repanics the original value
+// instead of swallowing it.
+func WithPanicFilter(filter func(recovered any) bool) TryOption {
+	return func(c *tryConfig) {
+		c.panicFilter = filter
+	}
+}
+
+// WithRepanicOn marks panic values matching any of the given types to always
+// repanic instead of being recovered. Pass an interface type, such as
+// reflect.TypeOf((*runtime.Error)(nil)).Elem(), to match by implementation
+// rather than exact type. This lets goroutine-fatal panics like nil-map
+// writes or divide-by-zero crash the process instead of being silently
+// converted into an error.
+func WithRepanicOn(types ...reflect.Type) TryOption {
+	return func(c *tryConfig) {
+		c.repanicOn = append(c.repanicOn, types...)
+	}
+}
+
+// WithPanicWrapper overrides how a recovered panic value is turned into an
+// error. It receives the recovered value and the stack trace captured at the
+// point of recovery.
+func WithPanicWrapper(wrapper func(recovered any, stack []byte) error) TryOption {
+	return func(c *tryConfig) {
+		c.panicWrapper = wrapper
+	}
+}
+
+// shouldRepanic reports whether the recovered value matches any of the given
+// types and should therefore propagate instead of being recovered.
+func shouldRepanic(recovered any, types []reflect.Type) bool {
+	if len(types) == 0 {
+		return false
+	}
+
+	rt := reflect.TypeOf(recovered)
+	if rt == nil {
+		return false
+	}
+
+	for _, t := range types {
+		if t == nil {
+			continue
+		}
+		if t.Kind() == reflect.Interface {
+			if rt.Implements(t) {
+				return true
+			}
+			continue
+		}
+		if rt == t {
+			return true
+		}
+	}
+	return false
+}
+
+// TryWith behaves like Try but applies opts to decide whether a recovered
+// panic is converted into an error or repanicked.
+func TryWith(fn func() error, opts ...TryOption) (err error) {
+	var cfg tryConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if shouldRepanic(r, cfg.repanicOn) {
+				panic(r)
+			}
+			if cfg.panicFilter != nil && !cfg.panicFilter(r) {
+				panic(r)
+			}
+
+			if cfg.panicWrapper != nil {
+				err = cfg.panicWrapper(r, debug.Stack())
+				return
+			}
+			err = newPanicError(r)
+		}
+	}()
+
+	return fn()
+}
+
+// Try0With has the same behavior as TryWith, but fn returns no value.
+func Try0With(fn func(), opts ...TryOption) error {
+	return TryWith(func() error {
+		fn()
+		return nil
+	}, opts...)
+}
+
+// Try1With executes a function and returns a result and an error, applying
+// opts to the recovered-panic policy.
+func Try1With[T any](fn func() (T, error), opts ...TryOption) (result T, err error) {
+	err = TryWith(func() error {
+		var fnErr error
+		result, fnErr = fn()
+		return fnErr
+	}, opts...)
+	return
+}
+
+// Try2With executes a function and returns two results and an error,
+// applying opts to the recovered-panic policy.
+func Try2With[T1, T2 any](fn func() (T1, T2, error), opts ...TryOption) (result1 T1, result2 T2, err error) {
+	err = TryWith(func() error {
+		var fnErr error
+		result1, result2, fnErr = fn()
+		return fnErr
+	}, opts...)
+	return
+}
+
+// Try3With executes a function and returns three results and an error,
+// applying opts to the recovered-panic policy.
+func Try3With[T1, T2, T3 any](fn func() (T1, T2, T3, error), opts ...TryOption) (result1 T1, result2 T2, result3 T3, err error) {
+	err = TryWith(func() error {
+		var fnErr error
+		result1, result2, result3, fnErr = fn()
+		return fnErr
+	}, opts...)
+	return
+}
+
+// TryCatchWith executes a function and calls the catch function if an error
+// occurs, applying opts to the recovered-panic policy.
+func TryCatchWith(fn func() error, catch func(error), opts ...TryOption) {
+	if err := TryWith(fn, opts...); err != nil {
+		catch(err)
+	}
+}
+
+// Try0CatchWith executes a function with no return value and calls the catch
+// function if an error occurs, applying opts to the recovered-panic policy.
+func Try0CatchWith(fn func(), catch func(error), opts ...TryOption) {
+	if err := Try0With(fn, opts...); err != nil {
+		catch(err)
+	}
+}
+
+// Try1CatchWith executes a function returning a result and an error, calls
+// the catch function if an error occurs, and applies opts to the
+// recovered-panic policy.
+func Try1CatchWith[T any](fn func() (T, error), catch func(error), opts ...TryOption) (result T) {
+	result, err := Try1With(fn, opts...)
+	if err != nil {
+		catch(err)
+	}
+	return
+}
+
+// Try2CatchWith executes a function returning two results and an error,
+// calls the catch function if an error occurs, and applies opts to the
+// recovered-panic policy.
+func Try2CatchWith[T1, T2 any](fn func() (T1, T2, error), catch func(error), opts ...TryOption) (result1 T1, result2 T2) {
+	result1, result2, err := Try2With(fn, opts...)
+	if err != nil {
+		catch(err)
+	}
+	return
+}
+
+// Try3CatchWith executes a function returning three results and an error,
+// calls the catch function if an error occurs, and applies opts to the
+// recovered-panic policy.
+func Try3CatchWith[T1, T2, T3 any](fn func() (T1, T2, T3, error), catch func(error), opts ...TryOption) (result1 T1, result2 T2, result3 T3) {
+	result1, result2, result3, err := Try3With(fn, opts...)
+	if err != nil {
+		catch(err)
+	}
+	return
+}
@@ -0,0 +1,78 @@
+package errorkit
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// ErrPanic is the sentinel matched by errors.Is against any PanicError,
+// regardless of the value it wraps.
+var ErrPanic = errors.New("panic recovered")
+
+// PanicError wraps a value recovered from a panic along with the call stack
+// captured at the point of recovery.
+type PanicError struct {
+	// Value is the original value passed to panic.
+	Value any
+	// Stack holds the call frames active when the panic was recovered, with
+	// the recover/defer machinery frames skipped.
+	Stack []runtime.Frame
+	// Cause is set when Value is itself an error, so the original error can
+	// still be reached via Unwrap/errors.As.
+	Cause error
+}
+
+// newPanicError builds a PanicError from a recovered value, capturing the
+// call stack above the recover/defer frames.
+func newPanicError(recovered any) *PanicError {
+	pe := &PanicError{Value: recovered, Stack: captureFrames(5)}
+	if cause, ok := recovered.(error); ok {
+		pe.Cause = cause
+	}
+	return pe
+}
+
+// captureFrames returns the call frames above skip, innermost first.
+func captureFrames(skip int) []runtime.Frame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip, pcs)
+	if n == 0 {
+		return nil
+	}
+
+	callerFrames := runtime.CallersFrames(pcs[:n])
+	var frames []runtime.Frame
+	for {
+		frame, more := callerFrames.Next()
+		frames = append(frames, frame)
+		if !more {
+			break
+		}
+	}
+	return frames
+}
+
+// Error implements the error interface, rendering the recovered value
+// followed by the captured stack trace.
+func (e *PanicError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "panic occurred: %v\nStack trace:\n", e.Value)
+	for _, f := range e.Stack {
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", f.Function, f.File, f.Line)
+	}
+	return b.String()
+}
+
+// Unwrap returns the original panic value when it was itself an error,
+// allowing errors.As to reach it.
+func (e *PanicError) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether target is ErrPanic, so callers can test
+// errors.Is(err, errorkit.ErrPanic) without knowing the recovered value.
+func (e *PanicError) Is(target error) bool {
+	return target == ErrPanic
+}
@@ -0,0 +1,59 @@
+package errorkit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPanicError_Value(t *testing.T) {
+	err := Try(func() error {
+		panic("boom")
+	})
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("errors.As() failed to extract *PanicError from %v", err)
+	}
+	if pe.Value != "boom" {
+		t.Errorf("PanicError.Value = %v, want %q", pe.Value, "boom")
+	}
+	if len(pe.Stack) == 0 {
+		t.Fatalf("PanicError.Stack is empty, want at least one frame")
+	}
+	if strings.Contains(pe.Stack[0].Function, "runtime.") {
+		t.Errorf("PanicError.Stack[0] = %q, want the panic site, not a runtime/defer frame", pe.Stack[0].Function)
+	}
+	if !strings.Contains(err.Error(), "panic occurred") {
+		t.Errorf("PanicError.Error() = %q, want it to contain %q", err.Error(), "panic occurred")
+	}
+}
+
+func TestPanicError_Is(t *testing.T) {
+	err := Try(func() error {
+		panic("boom")
+	})
+
+	if !errors.Is(err, ErrPanic) {
+		t.Errorf("errors.Is(err, ErrPanic) = false, want true")
+	}
+}
+
+func TestPanicError_Cause(t *testing.T) {
+	causeErr := errors.New("underlying error")
+
+	err := Try(func() error {
+		panic(causeErr)
+	})
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("errors.As() failed to extract *PanicError from %v", err)
+	}
+	if pe.Cause != causeErr {
+		t.Errorf("PanicError.Cause = %v, want %v", pe.Cause, causeErr)
+	}
+	if !errors.Is(err, causeErr) {
+		t.Errorf("errors.Is(err, causeErr) = false, want true")
+	}
+}
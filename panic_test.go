@@ -0,0 +1,106 @@
+package errorkit
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestTryWith_PanicFilter(t *testing.T) {
+	t.Run("filter allows recovery", func(t *testing.T) {
+		err := TryWith(func() error {
+			panic("boom")
+		}, WithPanicFilter(func(recovered any) bool {
+			return true
+		}))
+		if err == nil || !strings.Contains(err.Error(), "panic occurred") {
+			t.Errorf("TryWith() error = %v, want panic occurred", err)
+		}
+	})
+
+	t.Run("filter rejects recovery and repanics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("TryWith() did not repanic when filter returned false")
+			}
+		}()
+
+		_ = TryWith(func() error {
+			panic("boom")
+		}, WithPanicFilter(func(recovered any) bool {
+			return false
+		}))
+	})
+}
+
+func TestTryWith_RepanicOn(t *testing.T) {
+	runtimeErrType := reflect.TypeOf((*runtime.Error)(nil)).Elem()
+
+	t.Run("matching type repanics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("TryWith() did not repanic on matching type")
+			}
+		}()
+
+		_ = TryWith(func() error {
+			var m map[string]int
+			m["x"] = 1 // triggers a runtime.Error (nil map write)
+			return nil
+		}, WithRepanicOn(runtimeErrType))
+	})
+
+	t.Run("non-matching type is recovered", func(t *testing.T) {
+		err := TryWith(func() error {
+			panic("boom")
+		}, WithRepanicOn(runtimeErrType))
+		if err == nil || !strings.Contains(err.Error(), "panic occurred") {
+			t.Errorf("TryWith() error = %v, want panic occurred", err)
+		}
+	})
+}
+
+func TestTryWith_PanicWrapper(t *testing.T) {
+	wantErr := errors.New("custom wrapped panic")
+
+	err := TryWith(func() error {
+		panic("boom")
+	}, WithPanicWrapper(func(recovered any, stack []byte) error {
+		return wantErr
+	}))
+
+	if !errors.Is(err, wantErr) {
+		t.Errorf("TryWith() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestTry1With(t *testing.T) {
+	result, err := Try1With(func() (int, error) {
+		return 42, nil
+	})
+	if err != nil || result != 42 {
+		t.Errorf("Try1With() = %v, %v, want 42, nil", result, err)
+	}
+
+	_, err = Try1With(func() (int, error) {
+		panic("boom")
+	}, WithPanicFilter(func(recovered any) bool { return true }))
+	if err == nil || !strings.Contains(err.Error(), "panic occurred") {
+		t.Errorf("Try1With() error = %v, want panic occurred", err)
+	}
+}
+
+func TestTryCatchWith(t *testing.T) {
+	var handlerCalled bool
+	TryCatchWith(func() error {
+		panic("boom")
+	}, func(err error) {
+		handlerCalled = true
+	}, WithPanicFilter(func(recovered any) bool { return true }))
+
+	if !handlerCalled {
+		t.Errorf("TryCatchWith() handler not called")
+	}
+}
@@ -0,0 +1,197 @@
+package errorkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// RetryOption configures Retry and its typed variants.
+type RetryOption func(*retryConfig)
+
+type retryConfig struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	jitter      float64
+	ctx         context.Context
+	retryIf     func(error) bool
+	onRetry     func(attempt int, err error)
+	tryOpts     []TryOption
+}
+
+func newRetryConfig() *retryConfig {
+	return &retryConfig{
+		maxAttempts: 3,
+		ctx:         context.Background(),
+	}
+}
+
+// WithMaxAttempts sets the maximum number of attempts, including the first.
+// n must be at least 1. The default is 3.
+func WithMaxAttempts(n int) RetryOption {
+	return func(c *retryConfig) {
+		c.maxAttempts = n
+	}
+}
+
+// WithExponentialBackoff sets the delay between attempts, doubling from base
+// up to max after each failed attempt. jitter, between 0 and 1, randomizes
+// that delay by up to the given fraction to avoid thundering-herd retries.
+func WithExponentialBackoff(base, max time.Duration, jitter float64) RetryOption {
+	return func(c *retryConfig) {
+		c.baseDelay = base
+		c.maxDelay = max
+		c.jitter = jitter
+	}
+}
+
+// WithRetryContext aborts retrying early once ctx is done, returning the
+// last error joined with ctx.Err().
+func WithRetryContext(ctx context.Context) RetryOption {
+	return func(c *retryConfig) {
+		c.ctx = ctx
+	}
+}
+
+// WithRetryIf sets a predicate deciding whether a given error should trigger
+// another attempt, e.g. errors.Is(err, io.EOF). The default retries on any
+// non-nil error.
+func WithRetryIf(fn func(error) bool) RetryOption {
+	return func(c *retryConfig) {
+		c.retryIf = fn
+	}
+}
+
+// WithOnRetry registers a callback invoked after each failed attempt that
+// will be retried, before the next attempt's delay, with the 1-based attempt
+// number and its error. It is not invoked for the final failed attempt.
+func WithOnRetry(fn func(attempt int, err error)) RetryOption {
+	return func(c *retryConfig) {
+		c.onRetry = fn
+	}
+}
+
+// WithTryOptions passes TryOption values through to the underlying Try call
+// made on every attempt, e.g. to apply WithPanicFilter or WithRepanicOn.
+func WithTryOptions(opts ...TryOption) RetryOption {
+	return func(c *retryConfig) {
+		c.tryOpts = append(c.tryOpts, opts...)
+	}
+}
+
+// RetryError reports the outcome of a Retry call that never succeeded.
+type RetryError struct {
+	Attempts int
+	Elapsed  time.Duration
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("retry failed after %d attempt(s) in %s: %v", e.Attempts, e.Elapsed, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// delay returns the backoff delay before the given 1-based retry number, so
+// delay(1) is the base delay and it doubles on each subsequent retry.
+func (c *retryConfig) delay(attempt int) time.Duration {
+	if c.baseDelay <= 0 {
+		return 0
+	}
+
+	d := c.baseDelay
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if c.maxDelay > 0 && d >= c.maxDelay {
+			d = c.maxDelay
+			break
+		}
+	}
+
+	if c.jitter > 0 {
+		d = time.Duration(float64(d) * (1 - c.jitter + rand.Float64()*2*c.jitter))
+	}
+	return d
+}
+
+func (c *retryConfig) shouldRetry(err error) bool {
+	if c.retryIf != nil {
+		return c.retryIf(err)
+	}
+	return true
+}
+
+// Retry calls fn, retrying under the given options until it succeeds, the
+// context is done, or attempts are exhausted. Panics inside fn are converted
+// into errors via TryWith; pass WithTryOptions to customize that policy. On
+// exhaustion it returns a *RetryError wrapping the last error.
+func Retry(fn func() error, opts ...RetryOption) error {
+	cfg := newRetryConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	start := time.Now()
+	var lastErr error
+	attempts := 0
+
+	for attempt := 1; attempt <= cfg.maxAttempts; attempt++ {
+		attempts = attempt
+		lastErr = TryWith(fn, cfg.tryOpts...)
+		if lastErr == nil {
+			return nil
+		}
+		if attempt == cfg.maxAttempts || !cfg.shouldRetry(lastErr) {
+			break
+		}
+		if cfg.onRetry != nil {
+			cfg.onRetry(attempt, lastErr)
+		}
+
+		select {
+		case <-cfg.ctx.Done():
+			return &RetryError{Attempts: attempts, Elapsed: time.Since(start), Err: errors.Join(lastErr, cfg.ctx.Err())}
+		case <-time.After(cfg.delay(attempt)):
+		}
+	}
+
+	return &RetryError{Attempts: attempts, Elapsed: time.Since(start), Err: lastErr}
+}
+
+// Retry1 is the generic variant of Retry for functions returning a result
+// and an error.
+func Retry1[T any](fn func() (T, error), opts ...RetryOption) (result T, err error) {
+	err = Retry(func() error {
+		var fnErr error
+		result, fnErr = fn()
+		return fnErr
+	}, opts...)
+	return
+}
+
+// Retry2 is the generic variant of Retry for functions returning two
+// results and an error.
+func Retry2[T1, T2 any](fn func() (T1, T2, error), opts ...RetryOption) (result1 T1, result2 T2, err error) {
+	err = Retry(func() error {
+		var fnErr error
+		result1, result2, fnErr = fn()
+		return fnErr
+	}, opts...)
+	return
+}
+
+// Retry3 is the generic variant of Retry for functions returning three
+// results and an error.
+func Retry3[T1, T2, T3 any](fn func() (T1, T2, T3, error), opts ...RetryOption) (result1 T1, result2 T2, result3 T3, err error) {
+	err = Retry(func() error {
+		var fnErr error
+		result1, result2, result3, fnErr = fn()
+		return fnErr
+	}, opts...)
+	return
+}
@@ -0,0 +1,155 @@
+package errorkit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestRetry_SucceedsEventually(t *testing.T) {
+	attempts := 0
+	err := Retry(func() error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("not yet")
+		}
+		return nil
+	}, WithMaxAttempts(5))
+
+	if err != nil {
+		t.Fatalf("Retry() error = %v, want nil", err)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestRetry_ExhaustsAttempts(t *testing.T) {
+	wantErr := errors.New("always fails")
+	attempts := 0
+
+	err := Retry(func() error {
+		attempts++
+		return wantErr
+	}, WithMaxAttempts(3))
+
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+
+	var re *RetryError
+	if !errors.As(err, &re) {
+		t.Fatalf("errors.As() failed to extract *RetryError from %v", err)
+	}
+	if re.Attempts != 3 {
+		t.Errorf("RetryError.Attempts = %d, want 3", re.Attempts)
+	}
+	if !errors.Is(err, wantErr) {
+		t.Errorf("errors.Is(err, wantErr) = false, want true")
+	}
+}
+
+func TestRetry_RetryIf(t *testing.T) {
+	attempts := 0
+	err := Retry(func() error {
+		attempts++
+		return errors.New("unretryable")
+	}, WithMaxAttempts(5), WithRetryIf(func(err error) bool {
+		return errors.Is(err, io.EOF)
+	}))
+
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1 (retryIf should have stopped retrying)", attempts)
+	}
+	if err == nil {
+		t.Errorf("Retry() error = nil, want non-nil")
+	}
+}
+
+func TestRetry_OnRetryCallback(t *testing.T) {
+	var seen []int
+	attempts := 0
+
+	_ = Retry(func() error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("not yet")
+		}
+		return nil
+	}, WithMaxAttempts(5), WithOnRetry(func(attempt int, err error) {
+		seen = append(seen, attempt)
+	}))
+
+	if len(seen) != 2 {
+		t.Errorf("onRetry called %d times, want 2", len(seen))
+	}
+}
+
+func TestRetry_ContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts := 0
+	err := Retry(func() error {
+		attempts++
+		return errors.New("not yet")
+	}, WithMaxAttempts(5), WithExponentialBackoff(time.Millisecond, time.Second, 0), WithRetryContext(ctx))
+
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1 (context already cancelled)", attempts)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("errors.Is(err, context.Canceled) = false, want true")
+	}
+}
+
+func TestRetry_ExponentialBackoffTiming(t *testing.T) {
+	const base = 20 * time.Millisecond
+
+	var timestamps []time.Time
+	_ = Retry(func() error {
+		timestamps = append(timestamps, time.Now())
+		return errors.New("always fails")
+	}, WithMaxAttempts(3), WithExponentialBackoff(base, time.Second, 0))
+
+	if len(timestamps) != 3 {
+		t.Fatalf("attempts = %d, want 3", len(timestamps))
+	}
+
+	firstGap := timestamps[1].Sub(timestamps[0])
+	secondGap := timestamps[2].Sub(timestamps[1])
+
+	if firstGap < base || firstGap >= 2*base {
+		t.Errorf("first retry delay = %s, want ~%s (base)", firstGap, base)
+	}
+	if secondGap < 2*base {
+		t.Errorf("second retry delay = %s, want >= %s (2x base)", secondGap, 2*base)
+	}
+}
+
+func TestRetry_PanicConvertedToError(t *testing.T) {
+	err := Retry(func() error {
+		panic("boom")
+	}, WithMaxAttempts(1))
+
+	if !errors.Is(err, ErrPanic) {
+		t.Errorf("errors.Is(err, ErrPanic) = false, want true")
+	}
+}
+
+func TestRetry1(t *testing.T) {
+	attempts := 0
+	result, err := Retry1(func() (int, error) {
+		attempts++
+		if attempts < 2 {
+			return 0, errors.New("not yet")
+		}
+		return 42, nil
+	}, WithMaxAttempts(3))
+
+	if err != nil || result != 42 {
+		t.Errorf("Retry1() = %v, %v, want 42, nil", result, err)
+	}
+}
@@ -0,0 +1,158 @@
+package errorkit
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// wrapError adds a message in front of an existing error while keeping it
+// reachable via Unwrap/errors.Is/errors.As.
+type wrapError struct {
+	msg string
+	err error
+}
+
+func (e *wrapError) Error() string {
+	if e.msg == "" {
+		return e.err.Error()
+	}
+	return e.msg + ": " + e.err.Error()
+}
+
+func (e *wrapError) Unwrap() error {
+	return e.err
+}
+
+// Wrap annotates err with msg, returning an error that renders as
+// "msg: err" and still unwraps to err. Wrap returns nil if err is nil.
+func Wrap(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	return &wrapError{msg: msg, err: err}
+}
+
+// Wrapf is like Wrap but formats the message with fmt.Sprintf.
+func Wrapf(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	return Wrap(err, fmt.Sprintf(format, args...))
+}
+
+// contextError attaches key/value pairs to an existing error while keeping
+// it reachable via Unwrap/errors.Is/errors.As.
+type contextError struct {
+	kv  []any
+	err error
+}
+
+func (e *contextError) Error() string {
+	var b strings.Builder
+	for i := 0; i+1 < len(e.kv); i += 2 {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%v=%v", e.kv[i], e.kv[i+1])
+	}
+	if b.Len() == 0 {
+		return e.err.Error()
+	}
+	b.WriteString(": ")
+	b.WriteString(e.err.Error())
+	return b.String()
+}
+
+func (e *contextError) Unwrap() error {
+	return e.err
+}
+
+// WithContext annotates err with the given key/value pairs, rendering as
+// "k1=v1 k2=v2: err". kv must be an even number of elements; a trailing
+// unpaired key is dropped. WithContext returns nil if err is nil.
+func WithContext(err error, kv ...any) error {
+	if err == nil {
+		return nil
+	}
+	return &contextError{kv: kv, err: err}
+}
+
+// Cause walks err's Unwrap chain and returns the root error.
+func Cause(err error) error {
+	for {
+		unwrapped := errors.Unwrap(err)
+		if unwrapped == nil {
+			return err
+		}
+		err = unwrapped
+	}
+}
+
+// callerName returns the function name of the caller skip frames above
+// callerName itself, or "" if it can't be determined.
+func callerName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip)
+	if !ok {
+		return ""
+	}
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return ""
+	}
+	return fn.Name()
+}
+
+// wrapWithCaller wraps err with msg prefixed by the function name found skip
+// frames above callerName, so a skip of 3 names the caller of the function
+// that called wrapWithCaller.
+func wrapWithCaller(err error, msg string, skip int) error {
+	if err == nil {
+		return nil
+	}
+	if name := callerName(skip); name != "" {
+		if msg == "" {
+			msg = name
+		} else {
+			msg = name + ": " + msg
+		}
+	}
+	return Wrap(err, msg)
+}
+
+// TryWrap behaves like Try, but on error it annotates the result with msg
+// and the calling function's name so chained Try*Wrap calls build up a
+// stack-of-messages diagnostic without manual boilerplate at each call site.
+func TryWrap(fn func() error, msg string) error {
+	return wrapWithCaller(Try(fn), msg, 3)
+}
+
+// Try0Wrap has the same behavior as TryWrap, but fn returns no value.
+func Try0Wrap(fn func(), msg string) error {
+	return wrapWithCaller(Try0(fn), msg, 3)
+}
+
+// Try1Wrap executes a function and returns a result and an error, annotating
+// any error as TryWrap does.
+func Try1Wrap[T any](fn func() (T, error), msg string) (result T, err error) {
+	result, err = Try1(fn)
+	err = wrapWithCaller(err, msg, 3)
+	return
+}
+
+// Try2Wrap executes a function and returns two results and an error,
+// annotating any error as TryWrap does.
+func Try2Wrap[T1, T2 any](fn func() (T1, T2, error), msg string) (result1 T1, result2 T2, err error) {
+	result1, result2, err = Try2(fn)
+	err = wrapWithCaller(err, msg, 3)
+	return
+}
+
+// Try3Wrap executes a function and returns three results and an error,
+// annotating any error as TryWrap does.
+func Try3Wrap[T1, T2, T3 any](fn func() (T1, T2, T3, error), msg string) (result1 T1, result2 T2, result3 T3, err error) {
+	result1, result2, result3, err = Try3(fn)
+	err = wrapWithCaller(err, msg, 3)
+	return
+}
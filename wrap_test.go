@@ -0,0 +1,80 @@
+package errorkit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	if got := Wrap(nil, "msg"); got != nil {
+		t.Errorf("Wrap(nil, ...) = %v, want nil", got)
+	}
+
+	root := errors.New("root error")
+	err := Wrap(root, "context")
+	if err.Error() != "context: root error" {
+		t.Errorf("Wrap() error = %q, want %q", err.Error(), "context: root error")
+	}
+	if !errors.Is(err, root) {
+		t.Errorf("errors.Is(Wrap(root, ...), root) = false, want true")
+	}
+}
+
+func TestWrapf(t *testing.T) {
+	root := errors.New("root error")
+	err := Wrapf(root, "attempt %d", 3)
+	if err.Error() != "attempt 3: root error" {
+		t.Errorf("Wrapf() error = %q, want %q", err.Error(), "attempt 3: root error")
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	root := errors.New("root error")
+	err := WithContext(root, "user", "alice", "attempt", 2)
+	want := "user=alice attempt=2: root error"
+	if err.Error() != want {
+		t.Errorf("WithContext() error = %q, want %q", err.Error(), want)
+	}
+	if !errors.Is(err, root) {
+		t.Errorf("errors.Is(WithContext(root, ...), root) = false, want true")
+	}
+}
+
+func TestCause(t *testing.T) {
+	root := errors.New("root error")
+	wrapped := Wrap(Wrap(root, "outer"), "outer-outer")
+
+	if got := Cause(wrapped); got != root {
+		t.Errorf("Cause() = %v, want %v", got, root)
+	}
+	if got := Cause(root); got != root {
+		t.Errorf("Cause(root) = %v, want %v", got, root)
+	}
+}
+
+func TestTryWrap(t *testing.T) {
+	err := TryWrap(func() error {
+		return errors.New("boom")
+	}, "loading config")
+
+	if err == nil || !strings.Contains(err.Error(), "loading config: boom") {
+		t.Errorf("TryWrap() error = %v, want it to contain %q", err, "loading config: boom")
+	}
+	if !strings.Contains(err.Error(), "TestTryWrap") {
+		t.Errorf("TryWrap() error = %v, want it to contain the caller's function name", err)
+	}
+}
+
+func TestTry1Wrap(t *testing.T) {
+	result, err := Try1Wrap(func() (int, error) {
+		return 0, errors.New("boom")
+	}, "reading value")
+
+	if result != 0 {
+		t.Errorf("Try1Wrap() result = %v, want 0", result)
+	}
+	if err == nil || !strings.Contains(err.Error(), "reading value: boom") {
+		t.Errorf("Try1Wrap() error = %v, want it to contain %q", err, "reading value: boom")
+	}
+}